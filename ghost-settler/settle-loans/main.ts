@@ -103,7 +103,8 @@ function runMatchingEngine(
     collateralAmount: b.collateralAmount,
   }));
 
-  // Sort borrows largest-K-first, lends cheapest-rate-first
+  // Sort borrows largest-K-first, lends cheapest-rate-first. Sort is stable, so
+  // ties keep the server order from /internal/pending-intents (oldest first).
   borrows.sort((a, b) => b.amount - a.amount);
   lends.sort((a, b) => a.rate - b.rate);
 
@@ -0,0 +1,52 @@
+import { describe, it, expect } from "bun:test";
+import { parseAmount } from "../amount";
+import ghostRoute from "../routes/ghost.routes";
+import { config } from "../config";
+
+const gusd = config.TOKEN_ADDRESS;
+const geth = config.GETH_ADDRESS;
+
+describe("parseAmount", () => {
+  it("base-unit integer string → bigint", () => {
+    expect(parseAmount("10000000000000000000")).toBe(10000000000000000000n);
+  });
+
+  it("safe integer number → bigint", () => {
+    expect(parseAmount(42)).toBe(42n);
+  });
+
+  it("zero → null", () => {
+    expect(parseAmount("0")).toBeNull();
+    expect(parseAmount(0)).toBeNull();
+  });
+
+  it("negative → null", () => {
+    expect(parseAmount("-1")).toBeNull();
+    expect(parseAmount(-1)).toBeNull();
+  });
+
+  it("non-integer → null", () => {
+    expect(parseAmount("abc")).toBeNull();
+    expect(parseAmount("1.5")).toBeNull();
+    expect(parseAmount("0x10")).toBeNull();
+    expect(parseAmount(" 1")).toBeNull();
+    expect(parseAmount(1.5)).toBeNull();
+    expect(parseAmount(null)).toBeNull();
+  });
+});
+
+describe("amount params on routes", () => {
+  it("collateral-quote with non-numeric amount → 400", async () => {
+    const res = await ghostRoute.request(
+      `/collateral-quote?account=0x1&token=${gusd}&amount=abc&collateralToken=${geth}`,
+    );
+    expect(res.status).toBe(400);
+  });
+
+  it("swap-quote with negative amountIn → 400", async () => {
+    const res = await ghostRoute.request(
+      `/swap-quote?tokenIn=${gusd}&tokenOut=${geth}&amountIn=-1`,
+    );
+    expect(res.status).toBe(400);
+  });
+});
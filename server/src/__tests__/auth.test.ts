@@ -0,0 +1,85 @@
+import { describe, it, expect, beforeEach } from "bun:test";
+import { ethers } from "ethers";
+import {
+  authenticate,
+  checkNotReplayed,
+  EIP712_DOMAIN,
+  MAX_SEEN_SIGNATURES,
+  MESSAGE_TYPES,
+  releaseSignature,
+  resetSeenSignatures,
+} from "../auth";
+
+const wallet = ethers.Wallet.createRandom();
+const account = wallet.address;
+
+function ts() {
+  return Math.floor(Date.now() / 1000);
+}
+
+async function signCancelBorrow(intentId: string, timestamp: number) {
+  const message = { account, intentId, timestamp };
+  const types = { "Cancel Borrow": [...MESSAGE_TYPES["Cancel Borrow"]] };
+  const auth = await wallet.signTypedData(EIP712_DOMAIN, types, message);
+  return { message, auth };
+}
+
+beforeEach(() => {
+  resetSeenSignatures();
+});
+
+describe("authenticate replay guard", () => {
+  it("valid signature → accepted once", async () => {
+    const { message, auth } = await signCancelBorrow("intent-1", ts());
+    expect(() => authenticate("Cancel Borrow", message, auth, account)).not.toThrow();
+  });
+
+  it("same signature twice → rejected", async () => {
+    const { message, auth } = await signCancelBorrow("intent-1", ts());
+    authenticate("Cancel Borrow", message, auth, account);
+    expect(() => authenticate("Cancel Borrow", message, auth, account)).toThrow(
+      "Signature already used",
+    );
+  });
+
+  it("new timestamp → accepted", async () => {
+    const now = ts();
+    const first = await signCancelBorrow("intent-1", now);
+    const second = await signCancelBorrow("intent-1", now - 1);
+    authenticate("Cancel Borrow", first.message, first.auth, account);
+    expect(() =>
+      authenticate("Cancel Borrow", second.message, second.auth, account),
+    ).not.toThrow();
+  });
+
+  it("mismatched signer → not recorded", async () => {
+    const { message, auth } = await signCancelBorrow("intent-1", ts());
+    const other = ethers.Wallet.createRandom().address;
+    expect(() => authenticate("Cancel Borrow", message, auth, other)).toThrow(
+      "Signature mismatch",
+    );
+    expect(() => authenticate("Cancel Borrow", message, auth, account)).not.toThrow();
+  });
+});
+
+describe("releaseSignature", () => {
+  it("released after a failed handler → same payload accepted again", async () => {
+    const { message, auth } = await signCancelBorrow("intent-1", ts());
+    const key = authenticate("Cancel Borrow", message, auth, account);
+    releaseSignature(key);
+    expect(() => authenticate("Cancel Borrow", message, auth, account)).not.toThrow();
+  });
+});
+
+describe("checkNotReplayed", () => {
+  it("store full → evicts the oldest entry", () => {
+    const now = ts();
+    for (let i = 0; i < MAX_SEEN_SIGNATURES; i++) {
+      checkNotReplayed(account, `0x${i.toString(16)}`, now);
+    }
+    expect(() => checkNotReplayed(account, "0x1", now)).toThrow("Signature already used");
+
+    checkNotReplayed(account, "0xnew", now);
+    expect(() => checkNotReplayed(account, "0x0", now)).not.toThrow();
+  });
+});
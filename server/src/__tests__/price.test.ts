@@ -0,0 +1,72 @@
+import { describe, it, expect } from "bun:test";
+import { readPrice, type PriceFeed } from "../price";
+
+function okFeed(answer: bigint, decimals = 8n): PriceFeed & { calls: number } {
+  const feed = {
+    calls: 0,
+    latestAnswer: async () => {
+      feed.calls++;
+      return answer;
+    },
+    decimals: async () => decimals,
+  };
+  return feed;
+}
+
+function failingFeed(): PriceFeed & { calls: number } {
+  const feed = {
+    calls: 0,
+    latestAnswer: async (): Promise<bigint> => {
+      feed.calls++;
+      throw new Error("503 Service Unavailable");
+    },
+    decimals: async (): Promise<bigint> => {
+      throw new Error("503 Service Unavailable");
+    },
+  };
+  return feed;
+}
+
+function hangingFeed(): PriceFeed {
+  return {
+    latestAnswer: () => new Promise(() => {}),
+    decimals: () => new Promise(() => {}),
+  };
+}
+
+describe("readPrice", () => {
+  it("first endpoint healthy → uses it", async () => {
+    const first = okFeed(250000000000n);
+    const second = okFeed(1n);
+    expect(await readPrice([first, second])).toBe(2500);
+    expect(second.calls).toBe(0);
+  });
+
+  it("first endpoint errors → fails over to the next", async () => {
+    const second = okFeed(310012345678n);
+    expect(await readPrice([failingFeed(), second])).toBe(3100.12345678);
+    expect(second.calls).toBe(1);
+  });
+
+  it("after failover → last good endpoint is tried first", async () => {
+    const primary = failingFeed();
+    const backup = okFeed(200000000000n);
+    const sources: PriceFeed[] = [primary, backup];
+    await readPrice(sources);
+    expect(sources[0]).toBe(backup);
+
+    await readPrice(sources);
+    expect(primary.calls).toBe(1);
+    expect(backup.calls).toBe(2);
+  });
+
+  it("first endpoint hangs → times out and fails over", async () => {
+    expect(await readPrice([hangingFeed(), okFeed(200000000000n)], 50)).toBe(2000);
+  });
+
+  it("all endpoints fail → throws last error", async () => {
+    await expect(readPrice([failingFeed(), failingFeed()])).rejects.toThrow(
+      "503 Service Unavailable",
+    );
+  });
+});
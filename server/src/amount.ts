@@ -0,0 +1,12 @@
+// Token amounts arrive as base-unit integer strings. Returns null for anything
+// that is not a positive integer (negatives, decimals, hex, "abc") so callers
+// can answer 400 instead of letting BigInt() throw.
+export function parseAmount(value: unknown): bigint | null {
+  if (typeof value === "number") {
+    if (!Number.isSafeInteger(value) || value <= 0) return null;
+    return BigInt(value);
+  }
+  if (typeof value !== "string" || !/^\d+$/.test(value)) return null;
+  const amount = BigInt(value);
+  return amount > 0n ? amount : null;
+}
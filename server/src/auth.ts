@@ -66,6 +66,50 @@ export function checkTimestamp(timestamp: number): void {
   }
 }
 
+// signer:digest -> unix seconds after which checkTimestamp rejects it anyway.
+// Map keeps insertion order, so the first key is the oldest entry.
+const seenSignatures = new Map<string, number>();
+export const MAX_SEEN_SIGNATURES = 100_000;
+const SWEEP_INTERVAL_MS = 60_000;
+
+function sweepSeenSignatures(): void {
+  const now = Math.floor(Date.now() / 1000);
+  for (const [key, expiresAt] of seenSignatures) {
+    if (expiresAt < now) seenSignatures.delete(key);
+  }
+}
+
+setInterval(sweepSeenSignatures, SWEEP_INTERVAL_MS).unref();
+
+export function checkNotReplayed(
+  signer: string,
+  digest: string,
+  timestamp: number
+): string {
+  const key = `${signer.toLowerCase()}:${digest}`;
+  const expiresAt = seenSignatures.get(key);
+  if (expiresAt !== undefined && expiresAt >= Math.floor(Date.now() / 1000)) {
+    throw new Error("Signature already used");
+  }
+
+  // At the cap, drop the oldest entry rather than refuse new signers
+  if (seenSignatures.size >= MAX_SEEN_SIGNATURES) {
+    seenSignatures.delete(seenSignatures.keys().next().value!);
+  }
+  seenSignatures.set(key, timestamp + FIVE_MINUTES);
+  return key;
+}
+
+// For handlers that fail after authenticate(): lets the client retry the
+// same signed payload instead of having to sign again.
+export function releaseSignature(key: string): void {
+  seenSignatures.delete(key);
+}
+
+export function resetSeenSignatures(): void {
+  seenSignatures.clear();
+}
+
 export function verifySignature(
   types: Record<string, ethers.TypedDataField[]>,
   message: Record<string, unknown>,
@@ -79,7 +123,7 @@ export function authenticate(
   message: Record<string, unknown>,
   signature: string,
   expectedAccount: string
-): void {
+): string {
   checkTimestamp(Number(message.timestamp));
 
   const types: Record<string, ethers.TypedDataField[]> = {
@@ -92,4 +136,9 @@ export function authenticate(
       `Signature mismatch: recovered ${recovered}, expected ${expectedAccount}`
     );
   }
+
+  // Key on the typed-data digest, not the signature bytes, so a
+  // re-encoded signature over the same message is still a replay.
+  const digest = ethers.TypedDataEncoder.hash(EIP712_DOMAIN, types, message);
+  return checkNotReplayed(recovered, digest, Number(message.timestamp));
 }
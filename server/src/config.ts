@@ -18,8 +18,15 @@ export const config = {
   CHAIN_ID: Number(process.env.CHAIN_ID ?? "11155111"),
   PORT: Number(process.env.PORT ?? "8080"),
   INTERNAL_API_KEY: process.env.INTERNAL_API_KEY ?? "",
-  ARBITRUM_RPC_URL:
-    process.env.ARBITRUM_RPC_URL ?? "https://arbitrum-one-rpc.publicnode.com",
+  // Comma-separated, tried in order; ARBITRUM_RPC_URL still works for a single endpoint
+  ARBITRUM_RPC_URLS: (
+    process.env.ARBITRUM_RPC_URLS ??
+    process.env.ARBITRUM_RPC_URL ??
+    "https://arbitrum-one-rpc.publicnode.com"
+  )
+    .split(",")
+    .map((url) => url.trim())
+    .filter(Boolean),
   ETH_USD_FEED:
     process.env.ETH_USD_FEED ?? "0x639Fe6ab55C921f74e7fac1ee960C0B6293ba612",
   GETH_ADDRESS:
@@ -1,5 +1,6 @@
 import type { Context } from "hono";
-import { authenticate } from "../auth";
+import { authenticate, releaseSignature } from "../auth";
+import { parseAmount } from "../amount";
 import { getCollateralMultiplier, getCreditScore, debitBalance, queueTransfer } from "../state";
 import { getEthPrice } from "../price";
 import { config } from "../config";
@@ -9,6 +10,7 @@ import LoanModel from "../models/loan.model";
 import LendIntentModel from "../models/lend-intent.model";
 
 export const submitBorrowIntent = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const {
       account,
@@ -33,7 +35,12 @@ export const submitBorrowIntent = async (c: Context) => {
     )
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    const borrowAmt = parseAmount(amount);
+    const collateralAmt = parseAmount(collateralAmount);
+    if (borrowAmt === null || collateralAmt === null)
+      return c.json({ error: "amount and collateralAmount must be positive integers" }, 400);
+
+    sigKey = authenticate(
       "Submit Borrow",
       {
         account,
@@ -56,8 +63,6 @@ export const submitBorrowIntent = async (c: Context) => {
 
     const score = await getCreditScore(account);
     const multiplier = getCollateralMultiplier(score.tier);
-    const collateralAmt = BigInt(collateralAmount);
-    const borrowAmt = BigInt(amount);
     const ethPrice = isUsdCollateral ? null : await getEthPrice();
     const collateralValueUsd = isUsdCollateral
       ? Number(collateralAmt) / 1e18
@@ -84,28 +89,30 @@ export const submitBorrowIntent = async (c: Context) => {
       intentId,
       borrower: account.toLowerCase(),
       token: token.toLowerCase(),
-      amount: BigInt(amount).toString(),
+      amount: borrowAmt.toString(),
       encryptedMaxRate,
       collateralToken: collateralToken.toLowerCase(),
-      collateralAmount: BigInt(collateralAmount).toString(),
+      collateralAmount: collateralAmt.toString(),
       status: "pending",
       createdAt: Date.now(),
     });
 
     return c.json({ status: "borrow_intent_created", intentId });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
 
 export const cancelBorrow = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const { account, intentId, timestamp, auth } = await c.req.json();
 
     if (!account || !intentId || !timestamp || !auth)
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    sigKey = authenticate(
       "Cancel Borrow",
       { account, intentId, timestamp },
       auth,
@@ -134,18 +141,20 @@ export const cancelBorrow = async (c: Context) => {
       transferId,
     });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
 
 export const acceptProposal = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const { account, proposalId, timestamp, auth } = await c.req.json();
 
     if (!account || !proposalId || !timestamp || !auth)
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    sigKey = authenticate(
       "Accept Proposal",
       { account, proposalId, timestamp },
       auth,
@@ -246,18 +255,20 @@ export const acceptProposal = async (c: Context) => {
       transferId,
     });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
 
 export const claimExcessCollateral = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const { account, loanId, timestamp, auth } = await c.req.json();
 
     if (!account || !loanId || !timestamp || !auth)
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    sigKey = authenticate(
       "Claim Excess Collateral",
       { account, loanId, timestamp },
       auth,
@@ -299,18 +310,20 @@ export const claimExcessCollateral = async (c: Context) => {
       transferId,
     });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
 
 export const rejectProposal = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const { account, proposalId, timestamp, auth } = await c.req.json();
 
     if (!account || !proposalId || !timestamp || !auth)
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    sigKey = authenticate(
       "Reject Proposal",
       { account, proposalId, timestamp },
       auth,
@@ -365,6 +378,7 @@ export const rejectProposal = async (c: Context) => {
       transferId,
     });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
@@ -17,7 +17,9 @@ import {
 } from "../state";
 
 export const getPendingIntents = async (c: Context) => {
-  const pendingProposals = await MatchProposalModel.find({ status: "pending" }).lean();
+  const pendingProposals = await MatchProposalModel.find({ status: "pending" })
+    .sort({ createdAt: 1, proposalId: 1 })
+    .lean();
   const lockedLendIds = new Set<string>();
   for (const proposal of pendingProposals) {
     for (const tick of proposal.matchedTicks) {
@@ -25,7 +27,9 @@ export const getPendingIntents = async (c: Context) => {
     }
   }
 
-  const allLends = await LendIntentModel.find({}).lean();
+  const allLends = await LendIntentModel.find({})
+    .sort({ createdAt: 1, intentId: 1 })
+    .lean();
   const lendIntents = allLends
     .filter((l) => !lockedLendIds.has(l.intentId as string))
     .map((l) => ({
@@ -38,7 +42,9 @@ export const getPendingIntents = async (c: Context) => {
       createdAt: l.createdAt,
     }));
 
-  const borrowIntents = await BorrowIntentModel.find({ status: "pending" }).lean();
+  const borrowIntents = await BorrowIntentModel.find({ status: "pending" })
+    .sort({ createdAt: 1, intentId: 1 })
+    .lean();
   const mappedBorrows = borrowIntents.map((b) => ({
     intentId: b.intentId,
     borrower: b.borrower,
@@ -193,7 +199,9 @@ export const expireProposals = async (c: Context) => {
 };
 
 export const checkLoans = async (c: Context) => {
-  const loans = await LoanModel.find({ status: "active" }).lean();
+  const loans = await LoanModel.find({ status: "active" })
+    .sort({ maturity: 1, loanId: 1 })
+    .lean();
   return c.json({
     loans: loans.map((l) => ({
       loanId: l.loanId,
@@ -218,7 +226,9 @@ export const checkLoans = async (c: Context) => {
 };
 
 export const getPendingTransfers = async (c: Context) => {
-  const transfers = await PendingTransferModel.find({ status: "pending" }).lean();
+  const transfers = await PendingTransferModel.find({ status: "pending" })
+    .sort({ createdAt: 1, transferId: 1 })
+    .lean();
   return c.json({
     transfers: transfers.map((t) => ({
       id: t.transferId,
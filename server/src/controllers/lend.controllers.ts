@@ -1,5 +1,6 @@
 import type { Context } from "hono";
-import { authenticate } from "../auth";
+import { authenticate, releaseSignature } from "../auth";
+import { parseAmount } from "../amount";
 import {
   currentEpoch,
   creditBalance,
@@ -17,6 +18,10 @@ export const initDepositLend = async (c: Context) => {
     if (!account || !token || !amount)
       return c.json({ error: "Missing required fields" }, 400);
 
+    const lendAmt = parseAmount(amount);
+    if (lendAmt === null)
+      return c.json({ error: "amount must be a positive integer" }, 400);
+
     await expireOldSlots();
 
     const slotId = crypto.randomUUID();
@@ -25,7 +30,7 @@ export const initDepositLend = async (c: Context) => {
       slotId,
       userId: account.toLowerCase(),
       token: token.toLowerCase(),
-      amount: BigInt(amount).toString(),
+      amount: lendAmt.toString(),
       status: "pending",
       createdAt: Date.now(),
       epochId: currentEpoch,
@@ -38,6 +43,7 @@ export const initDepositLend = async (c: Context) => {
 };
 
 export const confirmDepositLend = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const { account, slotId, encryptedRate, timestamp, auth } =
       await c.req.json();
@@ -45,7 +51,7 @@ export const confirmDepositLend = async (c: Context) => {
     if (!account || !slotId || !encryptedRate || !timestamp || !auth)
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    sigKey = authenticate(
       "Confirm Deposit",
       { account, slotId, encryptedRate, timestamp },
       auth,
@@ -94,18 +100,20 @@ export const confirmDepositLend = async (c: Context) => {
       epochId: currentEpoch,
     });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
 
 export const cancelLend = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const { account, slotId, timestamp, auth } = await c.req.json();
 
     if (!account || !slotId || !timestamp || !auth)
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    sigKey = authenticate(
       "Cancel Lend",
       { account, slotId, timestamp },
       auth,
@@ -139,6 +147,7 @@ export const cancelLend = async (c: Context) => {
 
     return c.json({ status: "cancelled", transferId });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
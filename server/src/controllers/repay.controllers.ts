@@ -1,17 +1,23 @@
 import type { Context } from "hono";
-import { authenticate } from "../auth";
+import { authenticate, releaseSignature } from "../auth";
+import { parseAmount } from "../amount";
 import LoanModel from "../models/loan.model";
 import CreditScoreModel from "../models/credit-score.model";
 import { creditBalance, queueTransfer, getCreditScore, upgradeTier } from "../state";
 
 export const repayLoan = async (c: Context) => {
+  let sigKey: string | undefined;
   try {
     const { account, loanId, amount, timestamp, auth } = await c.req.json();
 
     if (!account || !loanId || !amount || !timestamp || !auth)
       return c.json({ error: "Missing required fields" }, 400);
 
-    authenticate(
+    const repayAmount = parseAmount(amount);
+    if (repayAmount === null)
+      return c.json({ error: "amount must be a positive integer" }, 400);
+
+    sigKey = authenticate(
       "Repay Loan",
       { account, loanId, amount, timestamp },
       auth,
@@ -33,7 +39,6 @@ export const repayLoan = async (c: Context) => {
       totalOwed += tickAmount + interest;
     }
 
-    const repayAmount = BigInt(amount);
     if (repayAmount < totalOwed)
       return c.json(
         {
@@ -87,6 +92,7 @@ export const repayLoan = async (c: Context) => {
       transferId,
     });
   } catch (err: any) {
+    if (sigKey) releaseSignature(sigKey);
     return c.json({ error: err.message }, 401);
   }
 };
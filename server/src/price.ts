@@ -6,19 +6,63 @@ const ABI = [
   "function latestAnswer() view returns (int256)",
 ];
 
-const provider = new ethers.JsonRpcProvider(config.ARBITRUM_RPC_URL);
-const feed = new ethers.Contract(config.ETH_USD_FEED, ABI, provider);
+const ARBITRUM_ONE = ethers.Network.from(42161);
+const ENDPOINT_TIMEOUT = 5_000; // 5s per RPC endpoint
+
+export type PriceFeed = {
+  decimals(): Promise<bigint>;
+  latestAnswer(): Promise<bigint>;
+};
+
+// One feed per RPC URL; staticNetwork skips chainId detection so a dead
+// endpoint fails on the call instead of retrying network detection forever.
+const feeds: PriceFeed[] = config.ARBITRUM_RPC_URLS.map(
+  (url) =>
+    new ethers.Contract(
+      config.ETH_USD_FEED,
+      ABI,
+      new ethers.JsonRpcProvider(url, ARBITRUM_ONE, { staticNetwork: ARBITRUM_ONE }),
+    ) as unknown as PriceFeed,
+);
 
 let cache: { price: number; ts: number } | null = null;
 const TTL = 60_000; // 60s
 
+function withTimeout<T>(p: Promise<T>, ms: number): Promise<T> {
+  let timer: ReturnType<typeof setTimeout>;
+  const timeout = new Promise<T>((_, reject) => {
+    timer = setTimeout(() => reject(new Error(`RPC timeout after ${ms}ms`)), ms);
+  });
+  return Promise.race([p, timeout]).finally(() => clearTimeout(timer));
+}
+
+// Reads the first feed that answers; errors only if every endpoint fails.
+// The feed that answered is moved to the front of `sources`, so a hung
+// primary costs one timeout rather than one per cache miss.
+export async function readPrice(
+  sources: PriceFeed[],
+  timeoutMs = ENDPOINT_TIMEOUT,
+): Promise<number> {
+  let lastErr: unknown = new Error("No price feed endpoints configured");
+  for (let i = 0; i < sources.length; i++) {
+    const feed = sources[i];
+    try {
+      const [answer, decimals] = await withTimeout(
+        Promise.all([feed.latestAnswer(), feed.decimals()]),
+        timeoutMs,
+      );
+      if (i > 0) sources.unshift(...sources.splice(i, 1));
+      return Number(answer) / 10 ** Number(decimals);
+    } catch (err) {
+      lastErr = err;
+    }
+  }
+  throw lastErr;
+}
+
 export async function getEthPrice(): Promise<number> {
   if (cache && Date.now() - cache.ts < TTL) return cache.price;
-  const [answer, decimals] = await Promise.all([
-    feed.latestAnswer(),
-    feed.decimals(),
-  ]);
-  const price = Number(answer) / 10 ** Number(decimals);
+  const price = await readPrice(feeds);
   cache = { price, ts: Date.now() };
   return price;
 }
@@ -29,6 +29,7 @@ import MatchProposalModel from "../models/match-proposal.model";
 import LoanModel from "../models/loan.model";
 import PendingTransferModel from "../models/pending-transfer.model";
 import { getEthPrice } from "../price";
+import { parseAmount } from "../amount";
 import { config } from "../config";
 import type { Context, Next } from "hono";
 
@@ -75,6 +76,10 @@ ghostRoute.get("/collateral-quote", async (c: Context) => {
   if (!account || !token || !amount || !collateralToken)
     return c.json({ error: "Required query params: account, token, amount, collateralToken" }, 400);
 
+  const borrowAmt = parseAmount(amount);
+  if (borrowAmt === null)
+    return c.json({ error: "amount must be a positive integer" }, 400);
+
   const ct = collateralToken.toLowerCase();
   const isUsdCollateral = ct === config.TOKEN_ADDRESS.toLowerCase();
   const isEthCollateral = ct === config.GETH_ADDRESS.toLowerCase();
@@ -83,7 +88,6 @@ ghostRoute.get("/collateral-quote", async (c: Context) => {
 
   const score = await getCreditScore(account);
   const multiplier = getCollateralMultiplier(score.tier);
-  const borrowAmt = BigInt(amount);
   const bt = token.toLowerCase();
   const isBorrowEth = bt === config.GETH_ADDRESS.toLowerCase();
   const needsEthPrice = isBorrowEth || isEthCollateral;
@@ -117,7 +121,9 @@ ghostRoute.get("/lender-status/:address", async (c: Context) => {
   }
 
   // Active lend intents
-  const lendDocs = await LendIntentModel.find({ userId: addr }).lean();
+  const lendDocs = await LendIntentModel.find({ userId: addr })
+    .sort({ createdAt: 1, intentId: 1 })
+    .lean();
   const activeLends = lendDocs.map((i) => ({
     intentId: i.intentId as string,
     slotId: intentToSlot.get(i.intentId as string) ?? "",
@@ -127,7 +133,9 @@ ghostRoute.get("/lender-status/:address", async (c: Context) => {
   }));
 
   // Loans where this address is a lender
-  const loanDocs = await LoanModel.find({ "matchedTicks.lender": addr }).lean();
+  const loanDocs = await LoanModel.find({ "matchedTicks.lender": addr })
+    .sort({ maturity: 1, loanId: 1 })
+    .lean();
   const activeLoans: any[] = [];
   const completedLoans: any[] = [];
   for (const loan of loanDocs) {
@@ -163,7 +171,9 @@ ghostRoute.get("/lender-status/:address", async (c: Context) => {
   }
 
   // Transfers to this lender
-  const transferDocs = await PendingTransferModel.find({ recipient: addr }).lean();
+  const transferDocs = await PendingTransferModel.find({ recipient: addr })
+    .sort({ createdAt: 1, transferId: 1 })
+    .lean();
   const pendingPayouts: any[] = [];
   const completedPayouts: any[] = [];
   for (const t of transferDocs) {
@@ -182,7 +192,9 @@ ghostRoute.get("/borrower-status/:address", async (c: Context) => {
   const intentDocs = await BorrowIntentModel.find({
     borrower: addr,
     status: { $in: ["pending", "proposed"] },
-  }).lean();
+  })
+    .sort({ createdAt: 1, intentId: 1 })
+    .lean();
   const pendingIntents = intentDocs.map((i) => ({
     intentId: i.intentId as string,
     token: i.token as string,
@@ -197,7 +209,9 @@ ghostRoute.get("/borrower-status/:address", async (c: Context) => {
   const proposalDocs = await MatchProposalModel.find({
     borrower: addr,
     status: "pending",
-  }).lean();
+  })
+    .sort({ createdAt: 1, proposalId: 1 })
+    .lean();
   const pendingProposals = proposalDocs.map((p) => ({
     proposalId: p.proposalId as string,
     token: p.token as string,
@@ -209,7 +223,9 @@ ghostRoute.get("/borrower-status/:address", async (c: Context) => {
   }));
 
   // Loans
-  const loanDocs = await LoanModel.find({ borrower: addr }).lean();
+  const loanDocs = await LoanModel.find({ borrower: addr })
+    .sort({ maturity: 1, loanId: 1 })
+    .lean();
   const activeLoans: any[] = [];
   const completedLoans: any[] = [];
   for (const loan of loanDocs) {
@@ -253,7 +269,9 @@ ghostRoute.get("/borrower-status/:address", async (c: Context) => {
   }
 
   // Transfers back to borrower (collateral returns, etc.)
-  const transferDocs = await PendingTransferModel.find({ recipient: addr }).lean();
+  const transferDocs = await PendingTransferModel.find({ recipient: addr })
+    .sort({ createdAt: 1, transferId: 1 })
+    .lean();
   const pendingTransfers: any[] = [];
   const completedTransfers: any[] = [];
   for (const t of transferDocs) {
@@ -299,8 +317,11 @@ ghostRoute.get("/swap-quote", async (c: Context) => {
   if (![gusd, geth].includes(inLower) || ![gusd, geth].includes(outLower))
     return c.json({ error: "Only gUSD and gETH supported" }, 400);
 
+  const amtIn = parseAmount(amountIn);
+  if (amtIn === null)
+    return c.json({ error: "amountIn must be a positive integer" }, 400);
+
   const ethPrice = await getEthPrice();
-  const amtIn = BigInt(amountIn);
 
   let amountOut: bigint;
   if (inLower === gusd && outLower === geth) {
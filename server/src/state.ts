@@ -53,6 +53,11 @@ export async function queueTransfer(
   return transferId;
 }
 
+// Amounts are stored as strings (they exceed 2^53), so $inc is out. Instead
+// each write is a compare-and-swap on the amount it read, retried on conflict,
+// so concurrent credits/debits to one balance cannot lose an update.
+const BALANCE_CAS_RETRIES = 10;
+
 export async function creditBalance(
   user: string,
   token: string,
@@ -60,14 +65,25 @@ export async function creditBalance(
 ): Promise<void> {
   const u = user.toLowerCase();
   const t = token.toLowerCase();
-  const doc = await BalanceModel.findOne({ user: u, token: t });
-  if (doc) {
-    const current = BigInt(doc.amount);
-    doc.amount = (current + amount).toString();
-    await doc.save();
-  } else {
-    await BalanceModel.create({ user: u, token: t, amount: amount.toString() });
+  for (let attempt = 0; attempt < BALANCE_CAS_RETRIES; attempt++) {
+    const doc = await BalanceModel.findOne({ user: u, token: t }).lean();
+    if (!doc) {
+      try {
+        await BalanceModel.create({ user: u, token: t, amount: amount.toString() });
+        return;
+      } catch (err: any) {
+        if (err?.code !== 11000) throw err; // lost the create race; retry as update
+        continue;
+      }
+    }
+    const next = (BigInt(doc.amount) + amount).toString();
+    const res = await BalanceModel.updateOne(
+      { user: u, token: t, amount: doc.amount },
+      { $set: { amount: next } },
+    );
+    if (res.matchedCount === 1) return;
   }
+  throw new Error(`Balance update conflict: ${u} ${t}`);
 }
 
 export async function debitBalance(
@@ -77,13 +93,18 @@ export async function debitBalance(
 ): Promise<boolean> {
   const u = user.toLowerCase();
   const t = token.toLowerCase();
-  const doc = await BalanceModel.findOne({ user: u, token: t });
-  if (!doc) return false;
-  const current = BigInt(doc.amount);
-  if (current < amount) return false;
-  doc.amount = (current - amount).toString();
-  await doc.save();
-  return true;
+  for (let attempt = 0; attempt < BALANCE_CAS_RETRIES; attempt++) {
+    const doc = await BalanceModel.findOne({ user: u, token: t }).lean();
+    if (!doc) return false;
+    const current = BigInt(doc.amount);
+    if (current < amount) return false;
+    const res = await BalanceModel.updateOne(
+      { user: u, token: t, amount: doc.amount },
+      { $set: { amount: (current - amount).toString() } },
+    );
+    if (res.matchedCount === 1) return true;
+  }
+  throw new Error(`Balance update conflict: ${u} ${t}`);
 }
 
 export async function getBalance(